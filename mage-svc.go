@@ -14,12 +14,10 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
-	"sync"
 	"syscall"
 	"time"
 
 	"github.com/magefile/mage/mg"
-	"golang.org/x/sys/unix"
 )
 
 // New defines a new service with the provided name that can be started and stopped using the returned values as Mage
@@ -43,16 +41,38 @@ func New(name string, options ...Option) Interface {
 
 // Interface describes the interface provided by a configured service.
 type Interface interface {
+	// ID returns the service's configured name.
+	ID() string
+
 	// Start returns a Mage target that will start the service if it is not already
-	// running and wait until it is ready based on the configured checks.
+	// running and wait until it is ready based on the configured checks.  If Supervise
+	// was configured, it does not return once ready: it blocks supervising the service
+	// until Stop is called from another invocation or its context is canceled.
 	Start() mg.Fn
 
 	// Stop returns a Mage target that will stop the service if it is already running
 	// and clean up its pidfile.
 	Stop() mg.Fn
 
+	// Signal sends sig directly to the service's running process, via its control
+	// socket if Socket is configured (so it reaches the process even from a different
+	// mage invocation), or directly otherwise.  It returns an error if the service is
+	// not running.
+	Signal(ctx context.Context, sig os.Signal) error
+
 	// Status returns the status of the service.
 	Status(context.Context) *Status
+
+	// Logs returns a Mage target that tails the service's current log file, like
+	// `tail -f`, until its context is canceled.  It only works if LogDir has been
+	// configured; otherwise the service's output was inherited from the mage process
+	// and was never captured.
+	Logs() mg.Fn
+
+	// Events streams the service's lifecycle transitions (Created, Started, Exited,
+	// Restarted) until ctx is done.  It requires Socket to be configured and a
+	// Supervise'd invocation of Start to be running to serve them.
+	Events(ctx context.Context) (<-chan Event, error)
 }
 
 // PIDFile specifies the PID file path, which defaults to name.pid in the service
@@ -140,6 +160,87 @@ func Env(environment ...string) Option {
 	}
 }
 
+// StopSignal specifies the first signal sent when stopping the service, overriding the
+// default SIGTERM.  The process is still escalated to SIGKILL if it does not exit.  It
+// is equivalent to StopSequence(sig, syscall.SIGKILL).
+func StopSignal(sig os.Signal) Option {
+	return func(cfg *config) { cfg.stopOpts.sequence = []os.Signal{sig, syscall.SIGKILL} }
+}
+
+// StopSequence specifies the escalating sequence of signals sent when stopping the
+// service, each given StopTimeout to take effect before the next is sent.  The default
+// sequence is SIGTERM, SIGINT, SIGKILL.
+func StopSequence(sig ...os.Signal) Option {
+	return func(cfg *config) { cfg.stopOpts.sequence = sig }
+}
+
+// StopTimeout specifies how long to wait for the process to exit after each signal in
+// the stop sequence before escalating to the next.  The default is ten seconds.
+func StopTimeout(d time.Duration) Option {
+	return func(cfg *config) { cfg.stopOpts.timeout = d }
+}
+
+// Supervise turns Start into a long-running supervisor instead of a fire-and-forget
+// launch: once the process exits, Start restarts it (subject to the Restart policy)
+// with exponential backoff starting at one second and capped at one minute, resetting
+// to the base once the process has stayed up longer than five seconds.  After
+// maxFailures consecutive fast failures (or the default of five, if maxFailures is not
+// positive) the service gives up and Status reports it Fatal.  Because of this, Start
+// blocks supervising the service until it is stopped or its context is canceled, rather
+// than returning as soon as the process is ready.
+func Supervise(maxFailures int) Option {
+	return func(cfg *config) {
+		cfg.supervise.enabled = true
+		cfg.supervise.maxFailures = maxFailures
+	}
+}
+
+// RestartPolicy controls when a Supervised service is restarted after it exits.
+type RestartPolicy int
+
+const (
+	// RestartOnFailure, the default, restarts the service only when it exits with a
+	// non-nil error, e.g. a non-zero exit code or a signal.
+	RestartOnFailure RestartPolicy = iota
+
+	// RestartAlways restarts the service whenever it exits, even cleanly.
+	RestartAlways
+
+	// RestartNever never restarts the service; Start reports how it exited.
+	RestartNever
+)
+
+// Restart specifies the policy for restarting a Supervised service after it exits.
+func Restart(policy RestartPolicy) Option {
+	return func(cfg *config) { cfg.supervise.policy = policy }
+}
+
+// LogDir specifies the directory that captured log output is written to, as
+// <dir>/<name>.log, instead of inheriting the mage process's stdout and stderr.  The
+// directory is created if it does not already exist.
+func LogDir(path string) Option {
+	return func(cfg *config) { cfg.log.dir = path }
+}
+
+// LogRotate specifies the size, in bytes, at which a service's log file is rotated, and
+// how many rotated generations are kept alongside the current one (as <path>.1,
+// <path>.2, and so on).  It has no effect unless LogDir is also configured.  The
+// default is ten megabytes, keeping five rotated files alongside the current one.
+func LogRotate(maxSize int64, maxFiles int) Option {
+	return func(cfg *config) { cfg.log.maxSize, cfg.log.maxFiles = maxSize, maxFiles }
+}
+
+// Socket enables a control socket for the service, letting other mage invocations query
+// State, Stop it, Signal it, or stream Events instead of independently polling the
+// pidfile.  If path is "", the socket defaults to the pidfile's path with a ".sock"
+// suffix.  Serving the socket requires Supervise, since only a Supervise'd Start stays
+// alive long enough to own it; without Supervise, a configured Socket is still used to
+// connect to another invocation's socket (e.g. for Stop or Status), but nothing in this
+// invocation will serve one.
+func Socket(path string) Option {
+	return func(cfg *config) { cfg.sock.enabled, cfg.sock.path = true, path }
+}
+
 type Option func(*config)
 
 type config struct {
@@ -151,10 +252,55 @@ type config struct {
 		env  []string
 		dir  string
 	}
+	stopOpts struct {
+		sequence []os.Signal
+		timeout  time.Duration
+	}
+	supervise struct {
+		enabled     bool
+		policy      RestartPolicy
+		maxFailures int
+	}
+	log struct {
+		dir      string
+		maxSize  int64
+		maxFiles int
+	}
+	sock struct {
+		enabled bool
+		path    string
+	}
 	checks []func(context.Context) error
 }
 
+// defaultStopSequence is sent, in order, when stopping a service that has not
+// configured StopSignal or StopSequence.
+var defaultStopSequence = []os.Signal{syscall.SIGTERM, syscall.SIGINT, syscall.SIGKILL}
+
+// defaultStopTimeout is the grace period given to each signal in the stop sequence
+// before escalating to the next, for a service that has not configured StopTimeout.
+const defaultStopTimeout = 10 * time.Second
+
+// Supervise's exponential backoff and crash-threshold defaults; see Supervise.
+const (
+	superviseBaseDelay    = 1 * time.Second
+	superviseMaxDelay     = 1 * time.Minute
+	superviseHealthyAfter = 5 * time.Second
+	superviseMaxFailures  = 5
+)
+
+// LogRotate's defaults; see LogRotate.
+const (
+	defaultLogMaxSize  = 10 * 1024 * 1024
+	defaultLogMaxFiles = 5
+)
+
 func (cfg *config) ID() string { return cfg.name }
+
+// supervised reports whether Supervise was configured, so that Group can refuse to use
+// this service as a DependsOn target: a Supervised Start never returns until stopped.
+func (cfg *config) supervised() bool { return cfg.supervise.enabled }
+
 func (cfg *config) running() bool {
 	return cfg.getProcess() != nil
 }
@@ -183,22 +329,160 @@ func (cfg *config) getPIDFile() (pid int, mtime time.Time) {
 }
 
 func (cfg *config) getProcessByPID(pid int, start time.Time) *os.Process {
-	sys, err := getSysinfo()
-	if err == nil && time.Since(start).Seconds() > float64(sys.Uptime) {
+	boot, err := bootTime()
+	if err == nil && start.Before(boot) {
 		return nil // system rebooted since the pidfile was created, wraparound likely.
 	}
+	if !processExists(pid) {
+		return nil // process did not exist or there is a permission problem.
+	}
+	if expect := cfg.expectedName(); expect != `` {
+		name, err := processName(pid)
+		if err == nil && name != `` && name != expect {
+			return nil // pid was reused by an unrelated process since the pidfile was written.
+		}
+	}
 	ps, err := os.FindProcess(pid)
 	if err != nil {
 		// This should never happen on UNIX, see godoc.
 		return nil // pid terminated
 	}
-	err = ps.Signal(syscall.Signal(0))
-	if err != nil {
-		return nil // process did not exist or there is a permission problem.
-	} // Signal 0 is not sent in UNIX, it just tests to see if it exists.
 	return ps
 }
 
+// nameFile returns the path of the sidecar file recording the basename of the command
+// this service expects to find running at its PID, used to detect PID reuse.
+func (cfg *config) nameFile() string { return cfg.pidFile + `.name` }
+
+// fatalFile returns the path of the sidecar file recording why a Supervised service
+// gave up restarting, so that Status can report it Fatal even after the pidfile is gone.
+func (cfg *config) fatalFile() string { return cfg.pidFile + `.fatal` }
+
+// stopFile returns the path of the sidecar file that stop writes before killing the
+// process, so that runSupervisor can recognize the exit that follows as intentional and
+// not restart it, even when Socket is not configured for the two invocations to
+// otherwise coordinate.
+func (cfg *config) stopFile() string { return cfg.pidFile + `.stop` }
+
+// stopRequested reports whether stop has asked for the process to be killed, whether or
+// not a control socket is involved.
+func (cfg *config) stopRequested() bool {
+	_, err := os.Stat(cfg.stopFile())
+	return err == nil
+}
+
+func (cfg *config) isFatal() bool {
+	_, err := os.Stat(cfg.fatalFile())
+	return err == nil
+}
+
+// logFile returns the path the service's captured output is written to, or "" if
+// LogDir has not been configured, in which case output is inherited from the mage
+// process instead.
+func (cfg *config) logFile() string {
+	if cfg.log.dir == `` {
+		return ``
+	}
+	return filepath.Join(cfg.log.dir, cfg.name+`.log`)
+}
+
+// sockPath returns the path of the service's control socket, or "" if Socket has not
+// been configured.
+func (cfg *config) sockPath() string {
+	if !cfg.sock.enabled {
+		return ``
+	}
+	if cfg.sock.path != `` {
+		return cfg.sock.path
+	}
+	return cfg.pidFile + `.sock`
+}
+
+// rotateLog rotates path if it has grown past the configured (or default) maxSize,
+// keeping up to maxFiles previous generations as <path>.1, <path>.2, and so on.
+func (cfg *config) rotateLog(path string) error {
+	maxSize := cfg.log.maxSize
+	if maxSize <= 0 {
+		maxSize = defaultLogMaxSize
+	}
+	maxFiles := cfg.log.maxFiles
+	if maxFiles <= 0 {
+		maxFiles = defaultLogMaxFiles
+	}
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if info.Size() < maxSize {
+		return nil
+	}
+	os.Remove(fmt.Sprintf(`%s.%d`, path, maxFiles))
+	for i := maxFiles - 1; i >= 1; i-- {
+		os.Rename(fmt.Sprintf(`%s.%d`, path, i), fmt.Sprintf(`%s.%d`, path, i+1))
+	}
+	return os.Rename(path, path+`.1`)
+}
+
+// logWriter relays a service's captured output into its log file, rotating the file
+// whenever it has grown past the configured (or default) maxSize.  Unlike rotateLog
+// alone, which only runs once at spawn, checking on every Write catches a long-lived
+// service that never restarts.
+type logWriter struct {
+	cfg  *config
+	path string
+	file *os.File
+}
+
+func newLogWriter(cfg *config, path string) (*logWriter, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &logWriter{cfg: cfg, path: path, file: file}, nil
+}
+
+func (w *logWriter) Write(p []byte) (int, error) {
+	maxSize := w.cfg.log.maxSize
+	if maxSize <= 0 {
+		maxSize = defaultLogMaxSize
+	}
+	if info, err := w.file.Stat(); err == nil && info.Size() >= maxSize {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	return w.file.Write(p)
+}
+
+// rotate closes the current file, hands off to rotateLog to do the renaming, and
+// reopens path so writes continue landing in a fresh file.
+func (w *logWriter) rotate() error {
+	err := w.file.Close()
+	if err != nil {
+		return err
+	}
+	err = w.cfg.rotateLog(w.path)
+	if err != nil {
+		return err
+	}
+	w.file, err = os.OpenFile(w.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	return err
+}
+
+func (w *logWriter) Close() error { return w.file.Close() }
+
+// expectedName returns the process basename recorded in the sidecar file at Start, or
+// "" if no sidecar file exists (e.g. it predates this feature, or PIDFile was changed).
+func (cfg *config) expectedName() string {
+	data, err := ioutil.ReadFile(cfg.nameFile())
+	if err != nil {
+		return ``
+	}
+	return strings.TrimSpace(string(data))
+}
+
 func (cfg *config) getProcess() *os.Process {
 	pid, mtime := cfg.getPIDFile()
 	if pid < 2 {
@@ -239,30 +523,135 @@ func (cfg *config) check(ctx context.Context) error {
 			return nil
 		}
 		time.Sleep(100 * time.Millisecond)
-		err = ps.Signal(syscall.Signal(0))
-		if err != nil {
+		if !processExists(ps.Pid) {
 			return fmt.Errorf(`process %v exited before checks were satisfied`, ps.Pid)
 		}
 	}
 }
 
+// kill stops ps by sending the configured stop sequence (SIGTERM, SIGINT, SIGKILL by
+// default), waiting up to StopTimeout after each signal for the process to exit before
+// escalating to the next.
 func (cfg *config) kill(ps *os.Process) error {
-	//TODO: progressive interrupt and wait.
-	err := ps.Kill()
-	if err != nil {
-		return err
+	sequence := cfg.stopOpts.sequence
+	if len(sequence) == 0 {
+		sequence = defaultStopSequence
+	}
+	timeout := cfg.stopOpts.timeout
+	if timeout <= 0 {
+		timeout = defaultStopTimeout
+	}
+	for _, sig := range sequence {
+		err := ps.Signal(sig)
+		if err != nil {
+			return nil // process is already gone.
+		}
+		if cfg.awaitExit(ps, timeout) {
+			return nil
+		}
+	}
+	return fmt.Errorf(`process %v did not exit after stop sequence`, ps.Pid)
+}
+
+// awaitExit polls processExists until ps exits or timeout elapses, returning true if the
+// process exited.  ps.Wait is not used here because Start, unless Supervise is
+// configured, calls Process.Release so that the child survives the mage invocation that
+// started it; Wait fails for such a
+// "foreign" PID, so a later mage invocation trying to stop it must poll instead.
+func (cfg *config) awaitExit(ps *os.Process, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if !processExists(ps.Pid) {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(100 * time.Millisecond)
 	}
-	_, err = ps.Wait()
-	return err
 }
 
 func (cfg *config) start(ctx context.Context) error {
 	if cfg.running() {
 		return cfg.check(ctx)
 	}
+	os.RemoveAll(cfg.fatalFile())
+	os.RemoveAll(cfg.stopFile())
 
+	cmd, err := cfg.spawn(ctx)
+	if err != nil {
+		return err
+	}
+	if !cfg.supervise.enabled {
+		err = cmd.Process.Release()
+		if err != nil {
+			_ = cfg.kill(cmd.Process)
+			return err
+		}
+	}
+	err = cfg.check(ctx)
+	if err != nil {
+		_ = cfg.kill(cmd.Process)
+		return err
+	}
+	if !cfg.supervise.enabled {
+		return nil
+	}
+	var ct *control
+	if cfg.sockPath() != `` {
+		ct = newControl(cfg, cmd.Process)
+		ctlCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			err := ct.serve(ctlCtx)
+			if err != nil && ctlCtx.Err() == nil {
+				fmt.Fprintln(os.Stderr, `svc: control socket:`, err)
+			}
+		}()
+		ct.broadcast(Event{Type: `Created`, Time: time.Now()})
+		ct.broadcast(Event{Type: `Started`, Time: time.Now()})
+	}
+	return cfg.runSupervisor(ctx, cmd, ct)
+}
+
+// spawn starts the configured command and records its pidfile and name sidecar, but
+// does not release it; callers that want a detached, fire-and-forget process must
+// Release the returned *exec.Cmd's Process themselves.
+func (cfg *config) spawn(ctx context.Context) (*exec.Cmd, error) {
 	cmd := exec.CommandContext(ctx, cfg.run.name, cfg.run.args...)
-	cmd.Stdout, cmd.Stderr, cmd.Stdin = os.Stdout, os.Stderr, nil
+	cmd.Stdin = nil
+	if path := cfg.logFile(); path != `` {
+		err := os.MkdirAll(cfg.log.dir, 0700)
+		if err != nil {
+			return nil, err
+		}
+		err = cfg.rotateLog(path)
+		if err != nil {
+			return nil, err
+		}
+		w, err := newLogWriter(cfg, path)
+		if err != nil {
+			return nil, err
+		}
+		r, pw, err := os.Pipe()
+		if err != nil {
+			w.Close()
+			return nil, err
+		}
+		// Output is relayed through this pipe, rather than handing the log file to the
+		// child directly, so that logWriter can rotate it mid-life: the child would
+		// otherwise keep writing to whatever inode its own fd pointed at, oblivious to
+		// any rename.
+		go func() {
+			defer r.Close()
+			defer w.Close()
+			io.Copy(w, r)
+		}()
+		defer pw.Close() // exec.Cmd dup's this into the child during Start.
+		cmd.Stdout, cmd.Stderr = pw, pw
+	} else {
+		cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	}
 	cmd.Env = append(os.Environ(), cfg.run.env...)
 	if cfg.run.dir != `` {
 		cmd.Dir = cfg.run.dir
@@ -270,7 +659,7 @@ func (cfg *config) start(ctx context.Context) error {
 	}
 	err := cmd.Start()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if dir := filepath.Dir(cfg.pidFile); dir != `` {
 		os.MkdirAll(dir, 0700)
@@ -278,41 +667,158 @@ func (cfg *config) start(ctx context.Context) error {
 	err = ioutil.WriteFile(cfg.pidFile, []byte(strconv.Itoa(cmd.Process.Pid)), 0600)
 	if err != nil {
 		_ = cfg.kill(cmd.Process)
-		return err
-	}
-	err = cmd.Process.Release()
+		return nil, err
+	}
+	// Record the name the kernel actually reports for this PID, not the pre-exec
+	// command string: Run may name a shell or a symlinked interpreter that execs into
+	// something else entirely (`sh` into `dash`, a versioned `python3`, ...), and
+	// comparing against the unresolved name would misidentify the process we just
+	// started as a PID-reuse victim the moment expectedName is checked.
+	name, err := processName(cmd.Process.Pid)
+	if err != nil || name == `` {
+		name = filepath.Base(cfg.run.name)
+	}
+	err = ioutil.WriteFile(cfg.nameFile(), []byte(name), 0600)
 	if err != nil {
 		_ = cfg.kill(cmd.Process)
-		return err
+		return nil, err
 	}
-	err = cfg.check(ctx)
-	if err != nil {
-		_ = cfg.kill(cmd.Process)
-		return err
+	return cmd, nil
+}
+
+// runSupervisor keeps cmd's process running until ctx is done, restarting it per the
+// configured RestartPolicy with exponential backoff and rewriting the pidfile and name
+// sidecar on every restart.  It gives up after cfg.supervise.maxFailures consecutive
+// restarts that did not stay up longer than superviseHealthyAfter, recording a fatal
+// sidecar so Status reports the service Fatal.  ct is nil unless Socket is configured,
+// in which case it also owns the control socket and can request a clean stop; either
+// way, cfg.stopRequested's sidecar file is checked too, since Stop must work without a
+// Socket configured at all.
+func (cfg *config) runSupervisor(ctx context.Context, cmd *exec.Cmd, ct *control) error {
+	delay := superviseBaseDelay
+	failures := 0
+	for {
+		if ct != nil {
+			ct.setProcess(cmd.Process)
+		}
+		started := time.Now()
+		exit := cmd.Wait()
+		if ct != nil {
+			ct.broadcast(Event{Type: `Exited`, Time: time.Now()})
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if (ct != nil && ct.stopRequested()) || cfg.stopRequested() {
+			os.RemoveAll(cfg.pidFile)
+			os.RemoveAll(cfg.nameFile())
+			os.RemoveAll(cfg.stopFile())
+			return nil
+		}
+		if time.Since(started) >= superviseHealthyAfter {
+			delay, failures = superviseBaseDelay, 0
+		} else {
+			failures++
+		}
+		if !cfg.shouldRestart(exit) {
+			return exit
+		}
+		maxFailures := cfg.supervise.maxFailures
+		if maxFailures <= 0 {
+			maxFailures = superviseMaxFailures
+		}
+		if failures >= maxFailures {
+			reason := fmt.Sprintf(`crashed %d times in a row`, failures)
+			_ = ioutil.WriteFile(cfg.fatalFile(), []byte(reason), 0600)
+			os.RemoveAll(cfg.pidFile)
+			os.RemoveAll(cfg.nameFile())
+			return fmt.Errorf(`service %s %s, giving up`, cfg.name, reason)
+		}
+		time.Sleep(delay)
+		delay *= 2
+		if delay > superviseMaxDelay {
+			delay = superviseMaxDelay
+		}
+		next, err := cfg.spawn(ctx)
+		if err != nil {
+			return err
+		}
+		cmd = next
+		if ct != nil {
+			ct.broadcast(Event{Type: `Restarted`, Time: time.Now()})
+		}
+	}
+}
+
+// shouldRestart reports whether a service exiting with exit should be restarted under
+// the configured RestartPolicy.
+func (cfg *config) shouldRestart(exit error) bool {
+	switch cfg.supervise.policy {
+	case RestartNever:
+		return false
+	case RestartAlways:
+		return true
+	default: // RestartOnFailure
+		return exit != nil
 	}
-	return nil
 }
 
 func (cfg *config) stop(ctx context.Context) error {
+	if rsp, ok := cfg.dialControl(`stop`, 0); ok {
+		if rsp.Error != `` {
+			return fmt.Errorf(`%s`, rsp.Error)
+		}
+		return nil
+	}
 	ps := cfg.getProcess()
 	if ps == nil {
 		os.RemoveAll(cfg.pidFile)
+		os.RemoveAll(cfg.nameFile())
+		os.RemoveAll(cfg.stopFile())
 		return nil
 	}
-	err := ps.Kill()
-	if err != nil {
-		return err
-	}
+	// Record that this exit is intentional before killing it, so that a runSupervisor
+	// loop in another invocation of this same service does not mistake it for a crash
+	// and restart it out from under us; dialControl above already covers this when
+	// Socket is configured, but Stop must work without one too.
+	_ = ioutil.WriteFile(cfg.stopFile(), []byte(time.Now().Format(time.RFC3339)), 0600)
 	defer os.RemoveAll(cfg.pidFile)
-	ps.Wait() // will fail unless this mage instance also did the start.
-	return nil
+	defer os.RemoveAll(cfg.nameFile())
+	defer os.RemoveAll(cfg.stopFile())
+	return cfg.kill(ps)
+}
+
+// Signal sends sig to the service's process, via its control socket if Socket is
+// configured, or directly via the pidfile otherwise.
+func (cfg *config) Signal(ctx context.Context, sig os.Signal) error {
+	num, ok := sig.(syscall.Signal)
+	if !ok {
+		return fmt.Errorf(`signal %v is not supported`, sig)
+	}
+	if rsp, ok := cfg.dialControl(`signal`, int(num)); ok {
+		if rsp.Error != `` {
+			return fmt.Errorf(`%s`, rsp.Error)
+		}
+		return nil
+	}
+	ps := cfg.getProcess()
+	if ps == nil {
+		return fmt.Errorf(`service %s is not running`, cfg.name)
+	}
+	return ps.Signal(sig)
 }
 
 func (cfg *config) Start() mg.Fn { return &start{*cfg} }
 func (cfg *config) Stop() mg.Fn  { return &stop{*cfg} }
+func (cfg *config) Logs() mg.Fn  { return &logs{*cfg} }
 func (cfg *config) Status(ctx context.Context) *Status {
+	if rsp, ok := cfg.dialControl(`state`, 0); ok && rsp.Status != nil {
+		return rsp.Status
+	}
 	nfo := new(Status)
 	nfo.Name = cfg.name
+	nfo.Fatal = cfg.isFatal()
+	nfo.LogFile = cfg.logFile()
 	nfo.PID, nfo.Started = cfg.getPIDFile()
 	if nfo.PID == 0 {
 		return nfo
@@ -347,6 +853,19 @@ type Status struct {
 
 	// Ready is true if the service passed all of its checks.
 	Ready bool `json:"ready,omitempty"`
+
+	// Fatal is true if a Supervised service exceeded its crash threshold and gave up
+	// restarting.
+	Fatal bool `json:"fatal,omitempty"`
+
+	// LogFile is the path of the service's captured log file, if LogDir has been
+	// configured.
+	LogFile string `json:"log_file,omitempty"`
+
+	// Members holds each member's Status keyed by its ID, if this Status describes a
+	// Group rather than a single service; Running and Ready are then a rollup that is
+	// true only if every member is.
+	Members map[string]*Status `json:"members,omitempty"`
 }
 
 // Print writes the status to stderr.
@@ -358,8 +877,25 @@ func (nfo *Status) Print() {
 func (nfo *Status) String() string {
 	var buf strings.Builder
 	buf.WriteString(nfo.Name)
+	if nfo.Members != nil {
+		// A Group's Status has no PID of its own; branch on its Running/Ready rollup
+		// instead of falling through to the single-service PID==0 case below, which
+		// would otherwise always report a fully running Group as "not running".
+		if !nfo.Running {
+			buf.WriteString(` is not running`)
+		} else if nfo.Ready {
+			buf.WriteString(` is running and ready`)
+		} else {
+			buf.WriteString(` is running but not ready`)
+		}
+		return buf.String()
+	}
 	if nfo.PID == 0 {
-		buf.WriteString(` is not running`)
+		if nfo.Fatal {
+			buf.WriteString(` is not running and gave up restarting after repeated crashes`)
+		} else {
+			buf.WriteString(` is not running`)
+		}
 		return buf.String()
 	} else if !nfo.Running {
 		buf.WriteString(` had pid `)
@@ -388,15 +924,44 @@ type stop struct{ config }
 func (cfg *stop) Name() string                  { return `stop` }
 func (cfg *stop) Run(ctx context.Context) error { return cfg.stop(ctx) }
 
-func getSysinfo() (*unix.Sysinfo_t, error) {
-	getSysinfoOnce.Do(func() {
-		sysinfoErr = unix.Sysinfo(&sysinfo)
-	})
-	return &sysinfo, sysinfoErr
-}
+type logs struct{ config }
 
-var (
-	getSysinfoOnce sync.Once
-	sysinfo        unix.Sysinfo_t
-	sysinfoErr     error
-)
+func (cfg *logs) Name() string                  { return `logs` }
+func (cfg *logs) Run(ctx context.Context) error { return cfg.tailLog(ctx) }
+
+// tailLog streams data appended to the service's log file to stdout, like `tail -f`,
+// until ctx is canceled.
+func (cfg *config) tailLog(ctx context.Context) error {
+	path := cfg.logFile()
+	if path == `` {
+		return fmt.Errorf(`service %s has no LogDir configured`, cfg.name)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, 32*1024)
+	for {
+		err := ctx.Err()
+		if err != nil {
+			return err
+		}
+		n, err := file.Read(buf)
+		if n > 0 {
+			os.Stdout.Write(buf[:n])
+		}
+		switch err {
+		case nil:
+			// keep reading.
+		case io.EOF:
+			time.Sleep(200 * time.Millisecond)
+		default:
+			return err
+		}
+	}
+}
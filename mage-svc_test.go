@@ -0,0 +1,82 @@
+//go:build !windows
+// +build !windows
+
+package svc
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestStatusStringGroup confirms a Group's aggregate Status, which has no PID of its
+// own, is reported by its Running/Ready rollup instead of falling into the
+// single-service PID==0 "is not running" case.
+func TestStatusStringGroup(t *testing.T) {
+	nfo := &Status{Name: `mygroup`, Running: true, Ready: true, Members: map[string]*Status{}}
+	if got, want := nfo.String(), `mygroup is running and ready`; got != want {
+		t.Fatalf(`String() = %q, want %q`, got, want)
+	}
+	nfo.Ready = false
+	if got, want := nfo.String(), `mygroup is running but not ready`; got != want {
+		t.Fatalf(`String() = %q, want %q`, got, want)
+	}
+	nfo.Running = false
+	if got, want := nfo.String(), `mygroup is not running`; got != want {
+		t.Fatalf(`String() = %q, want %q`, got, want)
+	}
+}
+
+// TestStartResolvesExecName confirms Start does not misidentify its own freshly spawned
+// process as a PID-reuse victim when Run names a shell that execs into a different
+// binary (as /bin/sh does into dash on this system). It lets the process exit on its
+// own rather than going through Stop/kill: a fire-and-forget service that is never
+// Wait'ed by anyone is only ever reaped once its launching mage invocation exits and it
+// is reparented, which does not happen within a single test process.
+func TestStartResolvesExecName(t *testing.T) {
+	dir := t.TempDir()
+	svc := New(`exec-name`, PIDFile(dir+`/exec-name.pid`), Run(`sh`, `-c`, `sleep 1`))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := svc.Start().Run(ctx)
+	if err != nil {
+		t.Fatalf(`Start: %v`, err)
+	}
+	if !svc.Status(ctx).Running {
+		t.Fatalf(`service should be running`)
+	}
+}
+
+// TestStopSupervisedWithoutSocket confirms Stop actually stops a Supervised service
+// when no Socket is configured to coordinate an intentional exit: runSupervisor must
+// recognize the stop sidecar file and not respawn the process Stop just killed.
+func TestStopSupervisedWithoutSocket(t *testing.T) {
+	dir := t.TempDir()
+	svc := New(`no-respawn`, PIDFile(dir+`/no-respawn.pid`), Run(`sleep`, `30`), Supervise(0))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- svc.Start().Run(ctx) }()
+	deadline := time.Now().Add(5 * time.Second)
+	for !svc.Status(context.Background()).Running {
+		if time.Now().After(deadline) {
+			t.Fatalf(`service never came up`)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	before := svc.Status(context.Background()).PID
+
+	err := svc.Stop().Run(context.Background())
+	if err != nil {
+		t.Fatalf(`Stop: %v`, err)
+	}
+
+	time.Sleep(2 * time.Second)
+	nfo := svc.Status(context.Background())
+	if nfo.Running {
+		t.Fatalf(`service respawned after Stop with pid %d (was %d)`, nfo.PID, before)
+	}
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,258 @@
+package svc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Event describes a lifecycle transition broadcast to Events listeners on a service's
+// control socket.
+type Event struct {
+	// Type is one of Created, Started, Exited, or Restarted.
+	Type string `json:"type"`
+
+	// Time is when the transition was observed.
+	Time time.Time `json:"time"`
+
+	// Service is the ID of the service that reported this event, set when the event
+	// was relayed through a Group's Events rather than read directly from a single
+	// service's control socket.
+	Service string `json:"service,omitempty"`
+}
+
+// controlRequest is one line of a control socket request.
+type controlRequest struct {
+	// Method is one of state, stop, signal, or events.
+	Method string `json:"method"`
+
+	// Signal is the signal number to send, for Method "signal".
+	Signal int `json:"signal,omitempty"`
+}
+
+// controlResponse is one line of a control socket's unary response, for every Method
+// except "events", which instead streams a series of Events.
+type controlResponse struct {
+	Status *Status `json:"status,omitempty"`
+	Error  string  `json:"error,omitempty"`
+}
+
+// control owns a Supervise'd service's process and serves its control socket so that
+// other mage invocations for the same service can share this one's state instead of
+// independently polling the pidfile.
+type control struct {
+	cfg *config
+
+	mu       sync.Mutex
+	proc     *os.Process
+	stopping bool
+	events   []chan Event
+}
+
+func newControl(cfg *config, proc *os.Process) *control {
+	return &control{cfg: cfg, proc: proc}
+}
+
+func (ct *control) setProcess(proc *os.Process) {
+	ct.mu.Lock()
+	ct.proc = proc
+	ct.mu.Unlock()
+}
+
+func (ct *control) currentProcess() *os.Process {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	return ct.proc
+}
+
+func (ct *control) stopRequested() bool {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	return ct.stopping
+}
+
+// requestStop marks the service as intentionally stopping, so that runSupervisor does
+// not restart it once its process exits, and sends it the configured stop sequence.
+func (ct *control) requestStop() error {
+	ct.mu.Lock()
+	ct.stopping = true
+	proc := ct.proc
+	ct.mu.Unlock()
+	if proc == nil {
+		return nil
+	}
+	return ct.cfg.kill(proc)
+}
+
+func (ct *control) broadcast(evt Event) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	for _, ch := range ct.events {
+		select {
+		case ch <- evt:
+		default: // a slow listener should not block the supervisor; it just misses one.
+		}
+	}
+}
+
+func (ct *control) subscribe() chan Event {
+	ch := make(chan Event, 16)
+	ct.mu.Lock()
+	ct.events = append(ct.events, ch)
+	ct.mu.Unlock()
+	return ch
+}
+
+func (ct *control) unsubscribe(ch chan Event) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	for i, other := range ct.events {
+		if other == ch {
+			ct.events = append(ct.events[:i], ct.events[i+1:]...)
+			return
+		}
+	}
+}
+
+// serve listens on the service's control socket until ctx is done.
+func (ct *control) serve(ctx context.Context) error {
+	path := ct.cfg.sockPath()
+	os.Remove(path) // clear a stale socket left behind by a prior crash.
+	listener, err := net.Listen(`unix`, path)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path)
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		go ct.handle(conn)
+	}
+}
+
+func (ct *control) handle(conn net.Conn) {
+	defer conn.Close()
+	var req controlRequest
+	err := json.NewDecoder(conn).Decode(&req)
+	if err != nil {
+		return
+	}
+	switch req.Method {
+	case `events`:
+		ch := ct.subscribe()
+		defer ct.unsubscribe(ch)
+		enc := json.NewEncoder(conn)
+		for evt := range ch {
+			if enc.Encode(evt) != nil {
+				return
+			}
+		}
+	case `signal`:
+		var rsp controlResponse
+		proc := ct.currentProcess()
+		if proc == nil {
+			rsp.Error = `service is not running`
+		} else if err := proc.Signal(syscall.Signal(req.Signal)); err != nil {
+			rsp.Error = err.Error()
+		}
+		rsp.Status = ct.status()
+		json.NewEncoder(conn).Encode(rsp)
+	case `stop`:
+		var rsp controlResponse
+		if err := ct.requestStop(); err != nil {
+			rsp.Error = err.Error()
+		}
+		rsp.Status = ct.status()
+		json.NewEncoder(conn).Encode(rsp)
+	default: // state: any other method just reports the current Status.
+		json.NewEncoder(conn).Encode(controlResponse{Status: ct.status()})
+	}
+}
+
+// status reports the service's Status as this invocation sees it, bypassing the control
+// socket: cfg.Status would otherwise dial this very socket and ask itself again.
+func (ct *control) status() *Status {
+	local := *ct.cfg
+	local.sock.enabled = false
+	return local.Status(context.Background())
+}
+
+// dialControl connects to the service's control socket and issues method, decoding a
+// unary controlResponse.  It reports ok=false if no Socket is configured, or nothing is
+// listening, so that callers fall back to pidfile-only behavior.
+func (cfg *config) dialControl(method string, sig int) (rsp controlResponse, ok bool) {
+	path := cfg.sockPath()
+	if path == `` {
+		return
+	}
+	conn, err := net.DialTimeout(`unix`, path, time.Second)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	err = json.NewEncoder(conn).Encode(controlRequest{Method: method, Signal: sig})
+	if err != nil {
+		return
+	}
+	err = json.NewDecoder(conn).Decode(&rsp)
+	if err != nil {
+		return
+	}
+	return rsp, true
+}
+
+// Events streams the service's lifecycle transitions from its control socket until ctx
+// is done.  It requires Socket to be configured and a Supervise'd invocation of Start
+// to be running to serve them.
+func (cfg *config) Events(ctx context.Context) (<-chan Event, error) {
+	path := cfg.sockPath()
+	if path == `` {
+		return nil, fmt.Errorf(`service %s has no Socket configured`, cfg.name)
+	}
+	conn, err := net.DialTimeout(`unix`, path, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	err = json.NewEncoder(conn).Encode(controlRequest{Method: `events`})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	ch := make(chan Event, 16)
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+	go func() {
+		defer close(ch)
+		defer conn.Close()
+		dec := json.NewDecoder(conn)
+		for {
+			var evt Event
+			err := dec.Decode(&evt)
+			if err != nil {
+				return
+			}
+			select {
+			case ch <- evt:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
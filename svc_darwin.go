@@ -0,0 +1,52 @@
+//go:build darwin
+// +build darwin
+
+package svc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// bootTime returns the time the system last booted, read from the kern.boottime sysctl.
+// It is used to recognize a pidfile that was written before a reboot, whose PID may
+// since have been reused by an unrelated process.
+func bootTime() (time.Time, error) {
+	raw, err := unix.SysctlRaw(`kern.boottime`)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var tv syscall.Timeval
+	err = binary.Read(bytes.NewReader(raw), binary.LittleEndian, &tv)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(tv.Sec), int64(tv.Usec)*1000), nil
+}
+
+// processExists reports whether pid refers to a running process that this process has
+// permission to signal.  Signal 0 is never actually delivered; it just probes existence.
+func processExists(pid int) bool {
+	return syscall.Kill(pid, syscall.Signal(0)) == nil
+}
+
+// processName returns the basename of the executable running as pid, by way of ps since
+// there is no /proc on Darwin.
+func processName(pid int) (string, error) {
+	out, err := exec.Command(`ps`, `-o`, `comm=`, `-p`, strconv.Itoa(pid)).Output()
+	if err != nil {
+		return ``, err
+	}
+	name := strings.TrimSpace(string(out))
+	if slash := strings.LastIndexByte(name, '/'); slash >= 0 {
+		name = name[slash+1:]
+	}
+	return name, nil
+}
@@ -0,0 +1,280 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/magefile/mage/mg"
+)
+
+// Group returns an Interface that brings up multiple services together: Start begins
+// independent branches in parallel according to the dependency DAG declared with
+// DependsOn, bringing up a dependent only once everything it depends on reports Ready;
+// Stop reverses that order, stopping a service only once everything that depends on it
+// has stopped.  Status aggregates every member's Status under Members, alongside a
+// Running/Ready rollup that is true only if every member is.  As with a single
+// Supervised service, if any member was configured with Supervise, the Group's own
+// Start does not return once every member is ready either: it keeps blocking,
+// supervising that member, until Stop is called from another invocation or its context
+// is canceled.
+func Group(name string, members ...Interface) Interface {
+	return &group{name: name, members: members}
+}
+
+// DependsOn wraps svc to declare that it depends on deps, which must be other members
+// of the same Group referenced by the same Interface values passed to Group, so that
+// svc is not started until all of them report Ready.
+func DependsOn(svc Interface, deps ...Interface) Interface {
+	return &depends{Interface: svc, deps: deps}
+}
+
+type depends struct {
+	Interface
+	deps []Interface
+}
+
+type group struct {
+	name    string
+	members []Interface
+}
+
+// groupNode is a Group member with its dependencies unwrapped from DependsOn, if any.
+type groupNode struct {
+	svc  Interface
+	deps []Interface
+}
+
+func (g *group) nodes() []groupNode {
+	nodes := make([]groupNode, len(g.members))
+	for i, member := range g.members {
+		if dep, ok := member.(*depends); ok {
+			deps := make([]Interface, len(dep.deps))
+			for j, d := range dep.deps {
+				if wrapped, ok := d.(*depends); ok {
+					d = wrapped.Interface // a dependency may be named by its own DependsOn wrapper.
+				}
+				deps[j] = d
+			}
+			nodes[i] = groupNode{svc: dep.Interface, deps: deps}
+		} else {
+			nodes[i] = groupNode{svc: member}
+		}
+	}
+	return nodes
+}
+
+// groupResult is the outcome of starting or stopping one Group member, signaled by
+// closing done once err (possibly nil) has been set.
+type groupResult struct {
+	done chan struct{}
+	err  error
+}
+
+// supervised is implemented by a member whose Start may never return on its own, so that
+// start can reject it as a DependsOn target before it deadlocks waiting for readiness.
+type supervised interface {
+	supervised() bool
+}
+
+func (g *group) ID() string { return g.name }
+
+func (g *group) Start() mg.Fn { return &groupStart{g} }
+func (g *group) Stop() mg.Fn  { return &groupStop{g} }
+func (g *group) Logs() mg.Fn  { return &groupLogs{g} }
+
+// Signal sends sig to every member, collecting any failures into a single error.
+func (g *group) Signal(ctx context.Context, sig os.Signal) error {
+	var failed []string
+	for _, n := range g.nodes() {
+		if err := n.svc.Signal(ctx, sig); err != nil {
+			failed = append(failed, fmt.Sprintf(`%s: %v`, n.svc.ID(), err))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf(`group %s failed to signal: %s`, g.name, strings.Join(failed, `; `))
+}
+
+func (g *group) Status(ctx context.Context) *Status {
+	nodes := g.nodes()
+	nfo := &Status{Name: g.name, Members: make(map[string]*Status, len(nodes))}
+	running, ready := true, true
+	for _, n := range nodes {
+		member := n.svc.Status(ctx)
+		nfo.Members[n.svc.ID()] = member
+		running = running && member.Running
+		ready = ready && member.Ready
+	}
+	nfo.Running, nfo.Ready = running, ready
+	return nfo
+}
+
+func (g *group) Events(ctx context.Context) (<-chan Event, error) {
+	nodes := g.nodes()
+	out := make(chan Event, 16)
+	var wg sync.WaitGroup
+	for _, n := range nodes {
+		events, err := n.svc.Events(ctx)
+		if err != nil {
+			continue // this member has no Socket configured; it just won't report Events.
+		}
+		wg.Add(1)
+		go func(id string, events <-chan Event) {
+			defer wg.Done()
+			for evt := range events {
+				evt.Service = id
+				select {
+				case out <- evt:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(n.svc.ID(), events)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out, nil
+}
+
+// start brings up every member in parallel, starting a member only once everything it
+// DependsOn has reported Ready (i.e. its Start target returned without error).
+func (g *group) start(ctx context.Context) error {
+	nodes := g.nodes()
+	dependedOn := make(map[Interface]bool, len(nodes))
+	for _, n := range nodes {
+		for _, dep := range n.deps {
+			dependedOn[dep] = true
+		}
+	}
+	for _, n := range nodes {
+		if !dependedOn[n.svc] {
+			continue
+		}
+		if sv, ok := n.svc.(supervised); ok && sv.supervised() {
+			return fmt.Errorf(`group %s: %s is Supervised and depended on by another member, but a Supervised Start does not return until it is stopped, so DependsOn can never see it become ready; drop the dependency edge or stop Supervising %s`, g.name, n.svc.ID(), n.svc.ID())
+		}
+	}
+	results := make(map[Interface]*groupResult, len(nodes))
+	for _, n := range nodes {
+		results[n.svc] = &groupResult{done: make(chan struct{})}
+	}
+	var wg sync.WaitGroup
+	for _, n := range nodes {
+		n, res := n, results[n.svc]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(res.done)
+			for _, dep := range n.deps {
+				depRes, ok := results[dep]
+				if !ok {
+					res.err = fmt.Errorf(`service %s depends on a service that is not in group %s`, n.svc.ID(), g.name)
+					return
+				}
+				select {
+				case <-depRes.done:
+					if depRes.err != nil {
+						res.err = fmt.Errorf(`dependency did not become ready: %w`, depRes.err)
+						return
+					}
+				case <-ctx.Done():
+					res.err = ctx.Err()
+					return
+				}
+			}
+			res.err = n.svc.Start().Run(ctx)
+		}()
+	}
+	wg.Wait()
+	return g.collect(nodes, results, `start`)
+}
+
+// stop brings down every member in parallel, stopping a member only once everything
+// that depends on it has already stopped, the reverse of start's ordering.
+func (g *group) stop(ctx context.Context) error {
+	nodes := g.nodes()
+	dependents := make(map[Interface][]Interface, len(nodes))
+	for _, n := range nodes {
+		for _, dep := range n.deps {
+			dependents[dep] = append(dependents[dep], n.svc)
+		}
+	}
+	results := make(map[Interface]*groupResult, len(nodes))
+	for _, n := range nodes {
+		results[n.svc] = &groupResult{done: make(chan struct{})}
+	}
+	var wg sync.WaitGroup
+	for _, n := range nodes {
+		n, res := n, results[n.svc]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(res.done)
+			for _, dependent := range dependents[n.svc] {
+				depRes, ok := results[dependent]
+				if !ok {
+					continue
+				}
+				select {
+				case <-depRes.done:
+				case <-ctx.Done():
+					res.err = ctx.Err()
+					return
+				}
+			}
+			res.err = n.svc.Stop().Run(ctx)
+		}()
+	}
+	wg.Wait()
+	return g.collect(nodes, results, `stop`)
+}
+
+// collect reports the combined errors, if any, from a start or stop pass over nodes.
+func (g *group) collect(nodes []groupNode, results map[Interface]*groupResult, verb string) error {
+	var failed []string
+	for _, n := range nodes {
+		if err := results[n.svc].err; err != nil {
+			failed = append(failed, fmt.Sprintf(`%s: %v`, n.svc.ID(), err))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf(`group %s failed to %s: %s`, g.name, verb, strings.Join(failed, `; `))
+}
+
+type groupStart struct{ *group }
+
+func (gs *groupStart) Name() string                  { return `start` }
+func (gs *groupStart) Run(ctx context.Context) error { return gs.group.start(ctx) }
+
+type groupStop struct{ *group }
+
+func (gs *groupStop) Name() string                  { return `stop` }
+func (gs *groupStop) Run(ctx context.Context) error { return gs.group.stop(ctx) }
+
+type groupLogs struct{ *group }
+
+func (gs *groupLogs) Name() string { return `logs` }
+
+// Run tails every member's log file concurrently until ctx is done, like `tail -f` over
+// all of them at once.  Members without LogDir configured are skipped.
+func (gs *groupLogs) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for _, n := range gs.group.nodes() {
+		n := n
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = n.svc.Logs().Run(ctx)
+		}()
+	}
+	wg.Wait()
+	return ctx.Err()
+}
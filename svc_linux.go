@@ -0,0 +1,44 @@
+//go:build linux
+// +build linux
+
+package svc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// bootTime returns the time the system last booted, derived from sysinfo(2) uptime.  It is
+// used to recognize a pidfile that was written before a reboot, whose PID may since have
+// been reused by an unrelated process.
+func bootTime() (time.Time, error) {
+	var info unix.Sysinfo_t
+	err := unix.Sysinfo(&info)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Now().Add(-time.Duration(info.Uptime) * time.Second), nil
+}
+
+// processExists reports whether pid refers to a running process that this process has
+// permission to signal.  Signal 0 is never actually delivered; it just probes existence.
+func processExists(pid int) bool {
+	return syscall.Kill(pid, syscall.Signal(0)) == nil
+}
+
+// processName returns the basename of the executable running as pid, read from the
+// /proc/<pid>/exe symlink.  /proc/<pid>/comm is deliberately not used here: the kernel
+// truncates it to TASK_COMM_LEN-1 (15) bytes, which misidentifies any service whose
+// binary basename is longer than that.
+func processName(pid int) (string, error) {
+	path, err := os.Readlink(fmt.Sprintf(`/proc/%d/exe`, pid))
+	if err != nil {
+		return ``, err
+	}
+	return filepath.Base(path), nil
+}
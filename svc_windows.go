@@ -0,0 +1,59 @@
+//go:build windows
+// +build windows
+
+package svc
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// getTickCount64 is GetTickCount64 from kernel32.dll, which x/sys/windows does not
+// export; it is loaded lazily the same way the rest of that package wraps syscalls.
+var getTickCount64 = windows.NewLazySystemDLL(`kernel32.dll`).NewProc(`GetTickCount64`)
+
+// bootTime returns the time the system last booted, derived from GetTickCount64.  It is
+// used to recognize a pidfile that was written before a reboot, whose PID may since have
+// been reused by an unrelated process.
+func bootTime() (time.Time, error) {
+	ticks, _, err := getTickCount64.Call()
+	if err != nil && err != windows.ERROR_SUCCESS {
+		return time.Time{}, err
+	}
+	return time.Now().Add(-time.Duration(ticks) * time.Millisecond), nil
+}
+
+// processExists reports whether pid refers to a still-running process, using
+// OpenProcess/GetExitCodeProcess since Windows has no signal 0 to probe with.
+func processExists(pid int) bool {
+	handle, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, uint32(pid))
+	if err != nil {
+		return false
+	}
+	defer windows.CloseHandle(handle)
+	var code uint32
+	err = windows.GetExitCodeProcess(handle, &code)
+	if err != nil {
+		return false
+	}
+	return code == uint32(windows.STATUS_PENDING) // STILL_ACTIVE shares this value.
+}
+
+// processName returns the basename of the executable running as pid.
+func processName(pid int) (string, error) {
+	handle, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, uint32(pid))
+	if err != nil {
+		return ``, err
+	}
+	defer windows.CloseHandle(handle)
+	buf := make([]uint16, windows.MAX_PATH)
+	size := uint32(len(buf))
+	err = windows.QueryFullProcessImageName(handle, 0, &buf[0], &size)
+	if err != nil {
+		return ``, fmt.Errorf(`querying image name for pid %d: %w`, pid, err)
+	}
+	return filepath.Base(windows.UTF16ToString(buf[:size])), nil
+}